@@ -0,0 +1,241 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return scheme.AddDefaultingFuncs(
+		SetDefaults_DaemonSet,
+		SetDefaults_Deployment,
+		SetDefaults_Job,
+		SetDefaults_CronJob,
+		SetDefaults_ReplicaSet,
+	)
+}
+
+// Default history limits for CronJobs, matching the historical behavior of keeping
+// a handful of recent Jobs around for troubleshooting without unbounded growth.
+const (
+	defaultSuccessfulJobsHistoryLimit int32 = 3
+	defaultFailedJobsHistoryLimit     int32 = 1
+)
+
+func SetDefaults_DaemonSet(obj *DaemonSet) {
+	labels := obj.Spec.Template.Labels
+
+	if len(obj.Labels) == 0 {
+		obj.Labels = labels
+	}
+
+	if obj.Spec.Selector == nil && obj.Spec.Template.Labels != nil {
+		obj.Spec.Selector = &LabelSelector{
+			MatchLabels: labels,
+		}
+	}
+
+	if obj.Spec.UpdateStrategy.Type == "" {
+		obj.Spec.UpdateStrategy.Type = RollingUpdateDaemonSetStrategyType
+	}
+	if obj.Spec.UpdateStrategy.Type == RollingUpdateDaemonSetStrategyType {
+		if obj.Spec.UpdateStrategy.RollingUpdate == nil {
+			obj.Spec.UpdateStrategy.RollingUpdate = &RollingUpdateDaemonSet{}
+		}
+		rollingUpdate := obj.Spec.UpdateStrategy.RollingUpdate
+		// MaxUnavailable and MaxSurge are mutually exclusive: when neither is set we
+		// preserve the historical default of a single unavailable pod at a time, and
+		// when only one is set the other defaults to 0 rather than clobbering it.
+		if rollingUpdate.MaxUnavailable == nil && rollingUpdate.MaxSurge == nil {
+			maxUnavailable := intstr.FromInt(1)
+			rollingUpdate.MaxUnavailable = &maxUnavailable
+		}
+		if rollingUpdate.MaxUnavailable == nil {
+			zero := intstr.FromInt(0)
+			rollingUpdate.MaxUnavailable = &zero
+		}
+		if rollingUpdate.MaxSurge == nil {
+			zero := intstr.FromInt(0)
+			rollingUpdate.MaxSurge = &zero
+		}
+	}
+	if obj.Spec.UniqueLabelKey == nil {
+		value := DefaultDaemonSetUniqueLabelKey
+		obj.Spec.UniqueLabelKey = &value
+	}
+}
+
+func SetDefaults_Deployment(obj *Deployment) {
+	// Set DeploymentSpec.Replicas to 1 if it is not set.
+	if obj.Spec.Replicas == nil {
+		obj.Spec.Replicas = new(int32)
+		*obj.Spec.Replicas = 1
+	}
+	strategy := &obj.Spec.Strategy
+	// Set default DeploymentStrategyType as RollingUpdate.
+	if strategy.Type == "" {
+		strategy.Type = RollingUpdateDeploymentStrategyType
+	}
+	if strategy.Type == RollingUpdateDeploymentStrategyType {
+		if strategy.RollingUpdate == nil {
+			strategy.RollingUpdate = &RollingUpdateDeployment{}
+		}
+		rollingUpdate := strategy.RollingUpdate
+		if rollingUpdate.MaxUnavailable == nil {
+			maxUnavailable := intstr.FromInt(1)
+			rollingUpdate.MaxUnavailable = &maxUnavailable
+		}
+		if rollingUpdate.MaxSurge == nil {
+			maxSurge := intstr.FromInt(1)
+			rollingUpdate.MaxSurge = &maxSurge
+		}
+	}
+	if strategy.Type == BlueGreenDeploymentStrategyType {
+		if strategy.BlueGreen == nil {
+			strategy.BlueGreen = &BlueGreenDeployment{}
+		}
+		blueGreen := strategy.BlueGreen
+		if blueGreen.AutoPromotionEnabled == nil {
+			autoPromotionEnabled := true
+			blueGreen.AutoPromotionEnabled = &autoPromotionEnabled
+		}
+		if blueGreen.ScaleDownDelaySeconds == nil {
+			scaleDownDelaySeconds := int32(30)
+			blueGreen.ScaleDownDelaySeconds = &scaleDownDelaySeconds
+		}
+	}
+	if strategy.Type == CanaryDeploymentStrategyType {
+		if strategy.Canary == nil {
+			strategy.Canary = &CanaryDeployment{}
+		}
+		canary := strategy.Canary
+		if canary.MaxSurge == nil {
+			maxSurge := intstr.FromInt(1)
+			canary.MaxSurge = &maxSurge
+		}
+		if canary.MaxUnavailable == nil {
+			maxUnavailable := intstr.FromInt(0)
+			canary.MaxUnavailable = &maxUnavailable
+		}
+	}
+	if obj.Spec.UniqueLabelKey == nil {
+		value := DefaultDeploymentUniqueLabelKey
+		obj.Spec.UniqueLabelKey = &value
+	}
+}
+
+// JobControllerUidLabel is the label injected into a Job's pod template, and used as
+// its generated selector, when the Job does not manage its own selector/labels.
+const JobControllerUidLabel = "controller-uid"
+
+func SetDefaults_Job(obj *Job) {
+	if len(obj.Labels) == 0 {
+		obj.Labels = obj.Spec.Template.Labels
+	}
+	if obj.Spec.Completions == nil {
+		completions := int32(1)
+		obj.Spec.Completions = &completions
+	}
+	if obj.Spec.Parallelism == nil {
+		parallelism := int32(1)
+		obj.Spec.Parallelism = &parallelism
+	}
+	if obj.Spec.BackoffLimit == nil {
+		backoffLimit := int32(6)
+		obj.Spec.BackoffLimit = &backoffLimit
+	}
+	if obj.Spec.ManualSelector == nil {
+		manualSelector := false
+		obj.Spec.ManualSelector = &manualSelector
+	}
+	// Deliberately does NOT auto-generate a controller-uid selector here: this
+	// defaulter runs at decode time (e.g. from roundTrip/api.Scheme.Convert), which
+	// for a client-submitted Create happens before the apiserver's registry
+	// PrepareForCreate assigns obj.UID. Generating the selector from an empty UID
+	// would give every such Job the identical selector {"controller-uid": ""},
+	// making them manage each other's pods. See EnsureJobSelector, which must be
+	// invoked once a real UID is available.
+}
+
+// EnsureJobSelector generates a controller-uid selector unique to this Job, and
+// stamps the matching label onto its pod template, unless the user has taken over
+// label/selector management themselves (ManualSelector=true) or already supplied a
+// Selector. This must run after obj.UID has been assigned a real value (e.g. from a
+// registry's PrepareForCreate, once one exists in this tree) — calling it from
+// SetDefaults_Job would silently collide every auto-selected Job on the same
+// empty-UID selector, since decode-time defaulting runs before UID assignment.
+func EnsureJobSelector(obj *Job) {
+	if obj.Spec.ManualSelector != nil && *obj.Spec.ManualSelector {
+		return
+	}
+	if obj.Spec.Selector != nil {
+		return
+	}
+	generatedLabels := map[string]string{JobControllerUidLabel: string(obj.UID)}
+	obj.Spec.Selector = &LabelSelector{MatchLabels: generatedLabels}
+	if obj.Spec.Template.Labels == nil {
+		obj.Spec.Template.Labels = map[string]string{}
+	}
+	for k, v := range generatedLabels {
+		obj.Spec.Template.Labels[k] = v
+	}
+}
+
+func SetDefaults_CronJob(obj *CronJob) {
+	if obj.Spec.ConcurrencyPolicy == "" {
+		obj.Spec.ConcurrencyPolicy = AllowConcurrent
+	}
+	if obj.Spec.Suspend == nil {
+		suspend := false
+		obj.Spec.Suspend = &suspend
+	}
+	if obj.Spec.SuccessfulJobsHistoryLimit == nil {
+		successfulJobsHistoryLimit := defaultSuccessfulJobsHistoryLimit
+		obj.Spec.SuccessfulJobsHistoryLimit = &successfulJobsHistoryLimit
+	}
+	if obj.Spec.FailedJobsHistoryLimit == nil {
+		failedJobsHistoryLimit := defaultFailedJobsHistoryLimit
+		obj.Spec.FailedJobsHistoryLimit = &failedJobsHistoryLimit
+	}
+	if obj.Spec.JobTemplate.Spec.Completions == nil {
+		completions := int32(1)
+		obj.Spec.JobTemplate.Spec.Completions = &completions
+	}
+	if obj.Spec.JobTemplate.Spec.Parallelism == nil {
+		parallelism := int32(1)
+		obj.Spec.JobTemplate.Spec.Parallelism = &parallelism
+	}
+}
+
+func SetDefaults_ReplicaSet(obj *ReplicaSet) {
+	labels := obj.Spec.Template.Labels
+
+	if len(obj.Labels) == 0 {
+		obj.Labels = labels
+	}
+	if obj.Spec.Selector == nil && obj.Spec.Template.Labels != nil {
+		obj.Spec.Selector = &LabelSelector{
+			MatchLabels: labels,
+		}
+	}
+	if obj.Spec.Replicas == nil {
+		obj.Spec.Replicas = new(int32)
+		*obj.Spec.Replicas = 1
+	}
+}
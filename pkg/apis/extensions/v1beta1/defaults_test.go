@@ -32,6 +32,8 @@ import (
 
 func TestSetDefaultDaemonSet(t *testing.T) {
 	defaultIntOrString := intstr.FromInt(1)
+	zeroIntOrString := intstr.FromInt(0)
+	differentIntOrString := intstr.FromInt(5)
 	defaultLabels := map[string]string{"foo": "bar"}
 	period := int64(v1.DefaultTerminationGracePeriodSeconds)
 	defaultTemplate := &v1.PodTemplateSpec{
@@ -68,6 +70,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 						Type: RollingUpdateDaemonSetStrategyType,
 						RollingUpdate: &RollingUpdateDaemonSet{
 							MaxUnavailable: &defaultIntOrString,
+							MaxSurge:       &zeroIntOrString,
 						},
 					},
 					UniqueLabelKey: newString(DefaultDaemonSetUniqueLabelKey),
@@ -87,6 +90,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 						Type: RollingUpdateDaemonSetStrategyType,
 						RollingUpdate: &RollingUpdateDaemonSet{
 							MaxUnavailable: &defaultIntOrString,
+							MaxSurge:       &zeroIntOrString,
 						},
 					},
 				},
@@ -106,6 +110,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 						Type: RollingUpdateDaemonSetStrategyType,
 						RollingUpdate: &RollingUpdateDaemonSet{
 							MaxUnavailable: &defaultIntOrString,
+							MaxSurge:       &zeroIntOrString,
 						},
 					},
 					UniqueLabelKey: newString(DefaultDaemonSetUniqueLabelKey),
@@ -120,6 +125,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 						Type: RollingUpdateDaemonSetStrategyType,
 						RollingUpdate: &RollingUpdateDaemonSet{
 							MaxUnavailable: &defaultIntOrString,
+							MaxSurge:       &zeroIntOrString,
 						},
 					},
 					UniqueLabelKey: newString(DefaultDaemonSetUniqueLabelKey),
@@ -140,6 +146,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 						Type: RollingUpdateDaemonSetStrategyType,
 						RollingUpdate: &RollingUpdateDaemonSet{
 							MaxUnavailable: &defaultIntOrString,
+							MaxSurge:       &zeroIntOrString,
 						},
 					},
 					UniqueLabelKey: newString(DefaultDaemonSetUniqueLabelKey),
@@ -161,12 +168,36 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 						Type: RollingUpdateDaemonSetStrategyType,
 						RollingUpdate: &RollingUpdateDaemonSet{
 							MaxUnavailable: &defaultIntOrString,
+							MaxSurge:       &zeroIntOrString,
 						},
 					},
 					UniqueLabelKey: newString("customDaemonSetKey"),
 				},
 			},
 		},
+		{ // MaxSurge set explicitly, MaxUnavailable defaulted to 0.
+			original: &DaemonSet{
+				Spec: DaemonSetSpec{
+					UpdateStrategy: DaemonSetUpdateStrategy{
+						RollingUpdate: &RollingUpdateDaemonSet{
+							MaxSurge: &differentIntOrString,
+						},
+					},
+				},
+			},
+			expected: &DaemonSet{
+				Spec: DaemonSetSpec{
+					UpdateStrategy: DaemonSetUpdateStrategy{
+						Type: RollingUpdateDaemonSetStrategyType,
+						RollingUpdate: &RollingUpdateDaemonSet{
+							MaxUnavailable: &zeroIntOrString,
+							MaxSurge:       &differentIntOrString,
+						},
+					},
+					UniqueLabelKey: newString(DefaultDaemonSetUniqueLabelKey),
+				},
+			},
+		},
 	}
 
 	for i, test := range tests {
@@ -187,6 +218,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 func TestSetDefaultDeployment(t *testing.T) {
 	defaultIntOrString := intstr.FromInt(1)
 	differentIntOrString := intstr.FromInt(5)
+	zeroCanaryIntOrString := intstr.FromInt(0)
 	deploymentLabelKey := DefaultDeploymentUniqueLabelKey
 	period := int64(v1.DefaultTerminationGracePeriodSeconds)
 	defaultTemplate := v1.PodTemplateSpec{
@@ -285,6 +317,62 @@ func TestSetDefaultDeployment(t *testing.T) {
 				},
 			},
 		},
+		{ // BlueGreen strategy defaults.
+			original: &Deployment{
+				Spec: DeploymentSpec{
+					Replicas: newInt32(5),
+					Strategy: DeploymentStrategy{
+						Type: BlueGreenDeploymentStrategyType,
+						BlueGreen: &BlueGreenDeployment{
+							ActiveService: "my-app-active",
+						},
+					},
+				},
+			},
+			expected: &Deployment{
+				Spec: DeploymentSpec{
+					Replicas: newInt32(5),
+					Strategy: DeploymentStrategy{
+						Type: BlueGreenDeploymentStrategyType,
+						BlueGreen: &BlueGreenDeployment{
+							AutoPromotionEnabled:  newBool(true),
+							ScaleDownDelaySeconds: newInt32(30),
+							ActiveService:         "my-app-active",
+						},
+					},
+					Template:       defaultTemplate,
+					UniqueLabelKey: newString(deploymentLabelKey),
+				},
+			},
+		},
+		{ // Canary strategy defaults.
+			original: &Deployment{
+				Spec: DeploymentSpec{
+					Replicas: newInt32(5),
+					Strategy: DeploymentStrategy{
+						Type: CanaryDeploymentStrategyType,
+						Canary: &CanaryDeployment{
+							Steps: []CanaryStep{{SetWeight: newInt32(25)}},
+						},
+					},
+				},
+			},
+			expected: &Deployment{
+				Spec: DeploymentSpec{
+					Replicas: newInt32(5),
+					Strategy: DeploymentStrategy{
+						Type: CanaryDeploymentStrategyType,
+						Canary: &CanaryDeployment{
+							Steps:          []CanaryStep{{SetWeight: newInt32(25)}},
+							MaxSurge:       &defaultIntOrString,
+							MaxUnavailable: &zeroCanaryIntOrString,
+						},
+					},
+					Template:       defaultTemplate,
+					UniqueLabelKey: newString(deploymentLabelKey),
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -303,73 +391,201 @@ func TestSetDefaultDeployment(t *testing.T) {
 }
 
 func TestSetDefaultJob(t *testing.T) {
-	expected := &Job{
-		Spec: JobSpec{
-			Selector: &LabelSelector{
+	tests := []struct {
+		name               string
+		original           *Job
+		expectCompletions  int32
+		expectParallelism  int32
+		expectBackoffLimit int32
+		expectManual       bool
+		expectSelector     *LabelSelector
+	}{
+		{
+			name: "unset fields get defaults, selector left for EnsureJobSelector",
+			original: &Job{
+				ObjectMeta: v1.ObjectMeta{UID: "my-job-uid"},
+				Spec:       JobSpec{},
+			},
+			expectCompletions:  1,
+			expectParallelism:  1,
+			expectBackoffLimit: 6,
+			expectManual:       false,
+			expectSelector:     nil,
+		},
+		{
+			name: "selector set explicitly is left alone",
+			original: &Job{
+				ObjectMeta: v1.ObjectMeta{UID: "my-job-uid"},
+				Spec: JobSpec{
+					Selector: &LabelSelector{
+						MatchLabels: map[string]string{"job": "selector"},
+					},
+				},
+			},
+			expectCompletions:  1,
+			expectParallelism:  1,
+			expectBackoffLimit: 6,
+			expectManual:       false,
+			expectSelector: &LabelSelector{
 				MatchLabels: map[string]string{"job": "selector"},
 			},
-			Completions: newInt32(1),
-			Parallelism: newInt32(1),
 		},
-	}
-	tests := []*Job{
-		// selector set explicitly, completions and parallelism - default
 		{
-			Spec: JobSpec{
-				Selector: &LabelSelector{
-					MatchLabels: map[string]string{"job": "selector"},
+			name: "ManualSelector=true skips auto-selector generation",
+			original: &Job{
+				ObjectMeta: v1.ObjectMeta{UID: "my-job-uid"},
+				Spec: JobSpec{
+					ManualSelector: newBool(true),
 				},
 			},
+			expectCompletions:  1,
+			expectParallelism:  1,
+			expectBackoffLimit: 6,
+			expectManual:       true,
+			expectSelector:     nil,
 		},
-		// selector from template labels, completions and parallelism - default
 		{
-			Spec: JobSpec{
-				Template: v1.PodTemplateSpec{
-					ObjectMeta: v1.ObjectMeta{
-						Labels: map[string]string{"job": "selector"},
-					},
+			name: "explicit BackoffLimit=0 is preserved",
+			original: &Job{
+				ObjectMeta: v1.ObjectMeta{UID: "my-job-uid"},
+				Spec: JobSpec{
+					BackoffLimit: newInt32(0),
 				},
 			},
+			expectCompletions:  1,
+			expectParallelism:  1,
+			expectBackoffLimit: 0,
+			expectManual:       false,
+			expectSelector:     nil,
+		},
+	}
+
+	for _, test := range tests {
+		obj2 := roundTrip(t, runtime.Object(test.original))
+		got, ok := obj2.(*Job)
+		if !ok {
+			t.Errorf("%s: unexpected object: %v", test.name, got)
+			t.FailNow()
+		}
+		if *got.Spec.Completions != test.expectCompletions {
+			t.Errorf("%s: got different completions than expected: %d %d", test.name, *got.Spec.Completions, test.expectCompletions)
+		}
+		if *got.Spec.Parallelism != test.expectParallelism {
+			t.Errorf("%s: got different parallelism than expected: %d %d", test.name, *got.Spec.Parallelism, test.expectParallelism)
+		}
+		if *got.Spec.BackoffLimit != test.expectBackoffLimit {
+			t.Errorf("%s: got different backoffLimit than expected: %d %d", test.name, *got.Spec.BackoffLimit, test.expectBackoffLimit)
+		}
+		if *got.Spec.ManualSelector != test.expectManual {
+			t.Errorf("%s: got different manualSelector than expected: %v %v", test.name, *got.Spec.ManualSelector, test.expectManual)
+		}
+		if !reflect.DeepEqual(got.Spec.Selector, test.expectSelector) {
+			t.Errorf("%s: got different selectors %#v %#v", test.name, got.Spec.Selector, test.expectSelector)
+		}
+	}
+}
+
+func TestEnsureJobSelector(t *testing.T) {
+	tests := []struct {
+		name           string
+		obj            *Job
+		expectSelector *LabelSelector
+	}{
+		{
+			name: "no selector and UID assigned generates one from the UID",
+			obj: &Job{
+				ObjectMeta: v1.ObjectMeta{UID: "my-job-uid"},
+			},
+			expectSelector: &LabelSelector{
+				MatchLabels: map[string]string{JobControllerUidLabel: "my-job-uid"},
+			},
 		},
-		// selector from template labels, completions set explicitly, parallelism - default
 		{
-			Spec: JobSpec{
-				Completions: newInt32(1),
-				Template: v1.PodTemplateSpec{
-					ObjectMeta: v1.ObjectMeta{
-						Labels: map[string]string{"job": "selector"},
-					},
+			name: "selector set explicitly is left alone",
+			obj: &Job{
+				ObjectMeta: v1.ObjectMeta{UID: "my-job-uid"},
+				Spec: JobSpec{
+					Selector: &LabelSelector{MatchLabels: map[string]string{"job": "selector"}},
 				},
 			},
+			expectSelector: &LabelSelector{MatchLabels: map[string]string{"job": "selector"}},
 		},
-		// selector from template labels, completions - default, parallelism set explicitly
 		{
-			Spec: JobSpec{
-				Parallelism: newInt32(1),
-				Template: v1.PodTemplateSpec{
-					ObjectMeta: v1.ObjectMeta{
-						Labels: map[string]string{"job": "selector"},
-					},
+			name: "ManualSelector=true skips generation",
+			obj: &Job{
+				ObjectMeta: v1.ObjectMeta{UID: "my-job-uid"},
+				Spec:       JobSpec{ManualSelector: newBool(true)},
+			},
+			expectSelector: nil,
+		},
+	}
+
+	for _, test := range tests {
+		EnsureJobSelector(test.obj)
+		if !reflect.DeepEqual(test.obj.Spec.Selector, test.expectSelector) {
+			t.Errorf("%s: got different selectors %#v %#v", test.name, test.obj.Spec.Selector, test.expectSelector)
+		}
+	}
+}
+
+func TestSetDefaultCronJob(t *testing.T) {
+	expected := &CronJob{
+		Spec: CronJobSpec{
+			ConcurrencyPolicy:          AllowConcurrent,
+			Suspend:                    newBool(false),
+			SuccessfulJobsHistoryLimit: newInt32(3),
+			FailedJobsHistoryLimit:     newInt32(1),
+			JobTemplate: JobTemplateSpec{
+				Spec: JobSpec{
+					Completions: newInt32(1),
+					Parallelism: newInt32(1),
 				},
 			},
 		},
 	}
+	tests := []*CronJob{
+		// everything is defaulted
+		{
+			Spec: CronJobSpec{
+				Schedule: "* * * * *",
+			},
+		},
+		// concurrency policy, suspend and history limits set explicitly
+		{
+			Spec: CronJobSpec{
+				Schedule:                   "* * * * *",
+				ConcurrencyPolicy:          AllowConcurrent,
+				Suspend:                    newBool(false),
+				SuccessfulJobsHistoryLimit: newInt32(3),
+				FailedJobsHistoryLimit:     newInt32(1),
+			},
+		},
+	}
 
 	for _, original := range tests {
 		obj2 := roundTrip(t, runtime.Object(original))
-		got, ok := obj2.(*Job)
+		got, ok := obj2.(*CronJob)
 		if !ok {
 			t.Errorf("unexpected object: %v", got)
 			t.FailNow()
 		}
-		if *got.Spec.Completions != *expected.Spec.Completions {
-			t.Errorf("got different completions than expected: %d %d", *got.Spec.Completions, *expected.Spec.Completions)
+		if got.Spec.ConcurrencyPolicy != expected.Spec.ConcurrencyPolicy {
+			t.Errorf("got different concurrencyPolicy than expected: %v %v", got.Spec.ConcurrencyPolicy, expected.Spec.ConcurrencyPolicy)
 		}
-		if *got.Spec.Parallelism != *expected.Spec.Parallelism {
-			t.Errorf("got different parallelism than expected: %d %d", *got.Spec.Parallelism, *expected.Spec.Parallelism)
+		if *got.Spec.Suspend != *expected.Spec.Suspend {
+			t.Errorf("got different suspend than expected: %v %v", *got.Spec.Suspend, *expected.Spec.Suspend)
 		}
-		if !reflect.DeepEqual(got.Spec.Selector, expected.Spec.Selector) {
-			t.Errorf("got different selectors %#v %#v", got.Spec.Selector, expected.Spec.Selector)
+		if *got.Spec.SuccessfulJobsHistoryLimit != *expected.Spec.SuccessfulJobsHistoryLimit {
+			t.Errorf("got different successfulJobsHistoryLimit than expected: %d %d", *got.Spec.SuccessfulJobsHistoryLimit, *expected.Spec.SuccessfulJobsHistoryLimit)
+		}
+		if *got.Spec.FailedJobsHistoryLimit != *expected.Spec.FailedJobsHistoryLimit {
+			t.Errorf("got different failedJobsHistoryLimit than expected: %d %d", *got.Spec.FailedJobsHistoryLimit, *expected.Spec.FailedJobsHistoryLimit)
+		}
+		if *got.Spec.JobTemplate.Spec.Completions != *expected.Spec.JobTemplate.Spec.Completions {
+			t.Errorf("got different completions than expected: %d %d", *got.Spec.JobTemplate.Spec.Completions, *expected.Spec.JobTemplate.Spec.Completions)
+		}
+		if *got.Spec.JobTemplate.Spec.Parallelism != *expected.Spec.JobTemplate.Spec.Parallelism {
+			t.Errorf("got different parallelism than expected: %d %d", *got.Spec.JobTemplate.Spec.Parallelism, *expected.Spec.JobTemplate.Spec.Parallelism)
 		}
 	}
 }
@@ -618,3 +834,9 @@ func newString(val string) *string {
 	*p = val
 	return p
 }
+
+func newBool(val bool) *bool {
+	p := new(bool)
+	*p = val
+	return p
+}
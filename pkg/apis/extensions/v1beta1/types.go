@@ -0,0 +1,502 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// LabelSelector is a label query over a set of resources, but in a structured manner.
+type LabelSelector struct {
+	// MatchLabels is a map of {key,value} pairs.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+	// MatchExpressions is a list of label selector requirements.
+	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+// LabelSelectorRequirement is a selector that contains values, a key, and an operator that
+// relates the key and values.
+type LabelSelectorRequirement struct {
+	Key      string                `json:"key" patchStrategy:"merge" patchMergeKey:"key"`
+	Operator LabelSelectorOperator `json:"operator"`
+	Values   []string              `json:"values,omitempty"`
+}
+
+type LabelSelectorOperator string
+
+const (
+	LabelSelectorOpIn           LabelSelectorOperator = "In"
+	LabelSelectorOpNotIn        LabelSelectorOperator = "NotIn"
+	LabelSelectorOpExists       LabelSelectorOperator = "Exists"
+	LabelSelectorOpDoesNotExist LabelSelectorOperator = "DoesNotExist"
+)
+
+// DaemonSetUpdateStrategyType is the enum of update strategies available for a daemon set.
+type DaemonSetUpdateStrategyType string
+
+const (
+	// Replace the old daemons only when it's killed
+	OnDeleteDaemonSetStrategyType DaemonSetUpdateStrategyType = "OnDelete"
+	// Replace the old daemons by new ones using rolling update i.e replace them on each node one after the other.
+	RollingUpdateDaemonSetStrategyType DaemonSetUpdateStrategyType = "RollingUpdate"
+)
+
+// DaemonSetUpdateStrategy is used to control the update strategy for a DaemonSet.
+type DaemonSetUpdateStrategy struct {
+	// Type of daemon set update. Can be "RollingUpdate" or "OnDelete". Default is RollingUpdate.
+	Type DaemonSetUpdateStrategyType `json:"type,omitempty"`
+
+	// Rolling update config params. Present only if type = "RollingUpdate".
+	RollingUpdate *RollingUpdateDaemonSet `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateDaemonSet controls the desired behavior of daemon set rolling update.
+type RollingUpdateDaemonSet struct {
+	// The maximum number of DaemonSet pods that can be unavailable during the
+	// update. Value can be an absolute number (ex: 5) or a percentage of total
+	// number of DaemonSet pods at the start of the update (ex: 10%). Absolute
+	// number is calculated from percentage by rounding up.
+	// This cannot be 0 if MaxSurge is 0.
+	// Default value is 1 when MaxSurge is also unset, and 0 when MaxSurge is set.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of DaemonSet pods that can be scheduled above the
+	// original number of existing DaemonSet pods. When a node is upgraded, a
+	// new pod is created on it first, and once it becomes available the old
+	// pod on that node is deleted, so that disruption-free upgrades are
+	// possible. Value can be an absolute number (ex: 5) or a percentage of
+	// total number of DaemonSet pods at the start of the update (ex: 10%).
+	// This cannot be 0 if MaxUnavailable is 0.
+	// Default value is 0.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over pods that are managed by the daemon set.
+	Selector *LabelSelector `json:"selector,omitempty"`
+
+	// Template is the object that describes the pod that will be created.
+	Template *v1.PodTemplateSpec `json:"template"`
+
+	// UpdateStrategy is the update strategy to use for this daemon set.
+	UpdateStrategy DaemonSetUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// UniqueLabelKey, if set, will be used to add a unique label to this daemon set's pods.
+	UniqueLabelKey *string `json:"uniqueLabelKey,omitempty"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	CurrentNumberScheduled int32 `json:"currentNumberScheduled"`
+	NumberMisscheduled     int32 `json:"numberMisscheduled"`
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+}
+
+// DaemonSet represents the configuration of a daemon set.
+type DaemonSet struct {
+	unversioned.TypeMeta `json:",inline"`
+	v1.ObjectMeta        `json:"metadata,omitempty"`
+
+	Spec   DaemonSetSpec   `json:"spec,omitempty"`
+	Status DaemonSetStatus `json:"status,omitempty"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []DaemonSet `json:"items"`
+}
+
+// DefaultDaemonSetUniqueLabelKey is the default label key that is added
+// to existing DaemonSet pods to distinguish between old and new DaemonSet pods.
+const DefaultDaemonSetUniqueLabelKey = "pod-template-generation"
+
+// DeploymentStrategyType describes the type of a deployment's rollout mechanism.
+type DeploymentStrategyType string
+
+const (
+	// Kill all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+	// Replace the old RCs by new one using rolling update i.e gradually scale down the old RCs and scale up the new one.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+	// Run the new ReplicaSet alongside the old one behind a preview Service until it is
+	// promoted, then flip a Service's selector to cut traffic over all at once.
+	BlueGreenDeploymentStrategyType DeploymentStrategyType = "BlueGreen"
+	// Gradually shift traffic from the old ReplicaSet to the new one in discrete steps,
+	// optionally pausing between steps for manual or timed promotion.
+	CanaryDeploymentStrategyType DeploymentStrategyType = "Canary"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of deployment. Can be "Recreate", "RollingUpdate", "BlueGreen" or "Canary".
+	// Default is RollingUpdate.
+	Type DeploymentStrategyType `json:"type,omitempty"`
+
+	// Rolling update config params. Present only if DeploymentStrategyType = RollingUpdate.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty"`
+
+	// Blue/green config params. Present only if DeploymentStrategyType = BlueGreen.
+	BlueGreen *BlueGreenDeployment `json:"blueGreen,omitempty"`
+
+	// Canary config params. Present only if DeploymentStrategyType = Canary.
+	Canary *CanaryDeployment `json:"canary,omitempty"`
+}
+
+// RollingUpdateDeployment controls the desired behavior of rolling update.
+type RollingUpdateDeployment struct {
+	// The maximum number of pods that can be unavailable during the update.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of pods that can be scheduled above the desired number of pods.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// BlueGreenDeployment controls the desired behavior of a blue/green rollout: the new
+// ReplicaSet ("green") is scaled up fully alongside the old one ("blue") behind a
+// preview Service, and traffic is cut over by repointing ActiveService's selector once
+// the rollout is promoted.
+type BlueGreenDeployment struct {
+	// AutoPromotionEnabled indicates whether the rollout should automatically promote
+	// the new ReplicaSet to active once it becomes fully available. Defaults to true.
+	AutoPromotionEnabled *bool `json:"autoPromotionEnabled,omitempty"`
+
+	// AutoPromotionSeconds, if set, delays an automatic promotion by this many seconds
+	// after the new ReplicaSet becomes fully available, giving time for smoke tests
+	// against PreviewService.
+	AutoPromotionSeconds *int32 `json:"autoPromotionSeconds,omitempty"`
+
+	// ScaleDownDelaySeconds is how long to keep the old ReplicaSet scaled up after
+	// promotion, before scaling it down. Defaults to 30.
+	ScaleDownDelaySeconds *int32 `json:"scaleDownDelaySeconds,omitempty"`
+
+	// PreviewService, if set, is the name of a Service whose selector is pointed at
+	// the new ReplicaSet so it can be verified before being promoted.
+	PreviewService string `json:"previewService,omitempty"`
+
+	// ActiveService is the name of the Service whose selector is switched to the new
+	// ReplicaSet once the rollout is promoted.
+	ActiveService string `json:"activeService,omitempty"`
+}
+
+// CanaryStep is one step of a canary rollout: either adjust the traffic weight given to
+// the new ReplicaSet, or pause the rollout. Exactly one of SetWeight or Pause must be set.
+type CanaryStep struct {
+	// SetWeight sets the percentage of traffic (and pod ratio between the old and new
+	// ReplicaSets) that should go to the new ReplicaSet.
+	SetWeight *int32 `json:"setWeight,omitempty"`
+
+	// Pause halts the rollout at this step until it is resumed, either after the given
+	// duration elapses or, if Indefinite, until an external caller sets
+	// Status.PauseConditions[i].Resume.
+	Pause *DurationOrIndefinite `json:"pause,omitempty"`
+}
+
+// DurationOrIndefinite is either a concrete wait duration or an indefinite pause that
+// only ends when resumed externally.
+type DurationOrIndefinite struct {
+	// Duration to pause for. Ignored if Indefinite is true.
+	Duration *unversioned.Duration `json:"duration,omitempty"`
+
+	// Indefinite, if true, means the pause never ends on its own.
+	Indefinite bool `json:"indefinite,omitempty"`
+}
+
+// CanaryDeployment controls the desired behavior of a canary rollout: Steps are walked
+// in order, gating the pod ratio between the old and new ReplicaSets by weight and
+// honoring pause steps along the way.
+type CanaryDeployment struct {
+	// Steps defines the sequence of weight and pause steps to run through. Weights
+	// must be monotonically non-decreasing. At least one step is required.
+	Steps []CanaryStep `json:"steps,omitempty"`
+
+	// The maximum number of pods that can be scheduled above the desired number of pods.
+	// Defaults to 1.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// The maximum number of pods that can be unavailable during the rollout.
+	// Defaults to 0.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// DeploymentSpec is the specification of the desired behavior of the Deployment.
+type DeploymentSpec struct {
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	Selector *LabelSelector `json:"selector,omitempty"`
+
+	Template v1.PodTemplateSpec `json:"template"`
+
+	Strategy DeploymentStrategy `json:"strategy,omitempty"`
+
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	Paused bool `json:"paused,omitempty"`
+
+	// UniqueLabelKey, if set, will be used to add a unique label to pods managed by this deployment.
+	UniqueLabelKey *string `json:"uniqueLabelKey,omitempty"`
+}
+
+// DeploymentStatus is the most recently observed status of the Deployment.
+type DeploymentStatus struct {
+	ObservedGeneration  int64 `json:"observedGeneration,omitempty"`
+	Replicas            int32 `json:"replicas,omitempty"`
+	UpdatedReplicas     int32 `json:"updatedReplicas,omitempty"`
+	AvailableReplicas   int32 `json:"availableReplicas,omitempty"`
+	UnavailableReplicas int32 `json:"unavailableReplicas,omitempty"`
+
+	// PauseConditions records, for a Canary rollout, whether each pause step in
+	// Spec.Strategy.Canary.Steps has been resumed. Indexed the same as Steps.
+	PauseConditions []DeploymentPauseCondition `json:"pauseConditions,omitempty"`
+}
+
+// DeploymentPauseCondition records whether a single canary pause step has been resumed.
+type DeploymentPauseCondition struct {
+	// Resume is set by an external caller to unblock a rollout that is waiting on
+	// an indefinite pause step.
+	Resume bool `json:"resume,omitempty"`
+
+	// StartTime is when the rollout entered this pause step.
+	StartTime *unversioned.Time `json:"startTime,omitempty"`
+}
+
+// Deployment enables declarative updates for Pods and ReplicaSets.
+type Deployment struct {
+	unversioned.TypeMeta `json:",inline"`
+	v1.ObjectMeta        `json:"metadata,omitempty"`
+
+	Spec DeploymentSpec `json:"spec,omitempty"`
+
+	Status DeploymentStatus `json:"status,omitempty"`
+}
+
+// DeploymentList is a list of Deployments.
+type DeploymentList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []Deployment `json:"items"`
+}
+
+// DefaultDeploymentUniqueLabelKey is the default key of the selector that is added
+// to existing RCs (and label key that is added to its pods) to prevent the existing RCs
+// to select new pods (and old pods) with the same label.
+const DefaultDeploymentUniqueLabelKey string = "pod-template-hash"
+
+// JobSpec describes how the job execution will look like.
+type JobSpec struct {
+	// Parallelism specifies the maximum desired number of pods the job should
+	// run at any given time.
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
+	// Completions specifies the desired number of successfully finished pods the
+	// job should be run with.
+	Completions *int32 `json:"completions,omitempty"`
+
+	// Optional duration in seconds relative to the startTime that the job may be active
+	// before the system tries to terminate it.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// BackoffLimit specifies the number of retries before marking this job failed.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// Selector is a label query over pods that should match the pod count.
+	Selector *LabelSelector `json:"selector,omitempty"`
+
+	// ManualSelector controls generation of pod labels and pod selectors.
+	// When false (the default), the system chooses labels unique to this job
+	// and appends those labels to the pod template. When true, the user is
+	// responsible for picking unique labels and specifying the selector, and
+	// the pod template's labels are used as-is without a generated selector.
+	ManualSelector *bool `json:"manualSelector,omitempty"`
+
+	// Template is the object that describes the pod that will be created when
+	// executing a job.
+	Template v1.PodTemplateSpec `json:"template"`
+}
+
+// JobStatus represents the current state of a Job.
+type JobStatus struct {
+	Conditions []JobCondition `json:"conditions,omitempty"`
+
+	StartTime      *unversioned.Time `json:"startTime,omitempty"`
+	CompletionTime *unversioned.Time `json:"completionTime,omitempty"`
+
+	Active    int32 `json:"active,omitempty"`
+	Succeeded int32 `json:"succeeded,omitempty"`
+	Failed    int32 `json:"failed,omitempty"`
+}
+
+type JobConditionType string
+
+const (
+	JobComplete JobConditionType = "Complete"
+	JobFailed   JobConditionType = "Failed"
+)
+
+// JobCondition describes current state of a job.
+type JobCondition struct {
+	Type               JobConditionType   `json:"type"`
+	Status             v1.ConditionStatus `json:"status"`
+	LastProbeTime      unversioned.Time   `json:"lastProbeTime,omitempty"`
+	LastTransitionTime unversioned.Time   `json:"lastTransitionTime,omitempty"`
+	Reason             string             `json:"reason,omitempty"`
+	Message            string             `json:"message,omitempty"`
+}
+
+// Job represents the configuration of a single job.
+type Job struct {
+	unversioned.TypeMeta `json:",inline"`
+	v1.ObjectMeta        `json:"metadata,omitempty"`
+
+	Spec JobSpec `json:"spec,omitempty"`
+
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []Job `json:"items"`
+}
+
+// JobTemplateSpec describes the data a Job should have when created from a template.
+type JobTemplateSpec struct {
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec JobSpec `json:"spec,omitempty"`
+}
+
+// ConcurrencyPolicy describes how the job will be handled.
+// Only one of the following concurrent policies may be specified.
+// If none of the following policies is specified, the default one
+// is AllowConcurrent.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent allows CronJobs to run concurrently.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+
+	// ForbidConcurrent forbids concurrent runs, skipping next run if previous
+	// hasn't finished yet.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+
+	// ReplaceConcurrent cancels currently running job and replaces it with a new one.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
+// CronJobSpec describes how the job execution will look like and when it will actually run.
+type CronJobSpec struct {
+	// Schedule is the cron expression (or one of the @hourly, @daily, @weekly,
+	// @monthly, @yearly macros) describing when the Job should be created.
+	Schedule string `json:"schedule"`
+
+	// Optional deadline in seconds for starting the job if it misses scheduled
+	// time for any reason. Missed jobs executions will be counted as failed ones.
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat concurrent executions of a Job.
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend flags that the controller should suspend subsequent executions,
+	// it does not apply to already started executions.
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// JobTemplate is the object that describes the Job that will be created when
+	// executing a CronJob.
+	JobTemplate JobTemplateSpec `json:"jobTemplate"`
+
+	// SuccessfulJobsHistoryLimit is the number of successful finished jobs to retain.
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// FailedJobsHistoryLimit is the number of failed finished jobs to retain.
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+}
+
+// CronJobStatus represents the current state of a cron job.
+type CronJobStatus struct {
+	// Active holds pointers to currently running jobs.
+	Active []v1.ObjectReference `json:"active,omitempty"`
+
+	// LastScheduleTime keeps information of when was the last time the job was successfully scheduled.
+	LastScheduleTime *unversioned.Time `json:"lastScheduleTime,omitempty"`
+}
+
+// CronJob represents the configuration of a single cron job.
+type CronJob struct {
+	unversioned.TypeMeta `json:",inline"`
+	v1.ObjectMeta        `json:"metadata,omitempty"`
+
+	Spec CronJobSpec `json:"spec,omitempty"`
+
+	Status CronJobStatus `json:"status,omitempty"`
+}
+
+// CronJobList is a collection of cron jobs.
+type CronJobList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []CronJob `json:"items"`
+}
+
+// ReplicaSetSpec is the specification of a ReplicaSet.
+type ReplicaSetSpec struct {
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	Selector *LabelSelector `json:"selector,omitempty"`
+
+	Template *v1.PodTemplateSpec `json:"template,omitempty"`
+}
+
+// ReplicaSetStatus represents the current status of a ReplicaSet.
+type ReplicaSetStatus struct {
+	Replicas             int32 `json:"replicas"`
+	FullyLabeledReplicas int32 `json:"fullyLabeledReplicas,omitempty"`
+	ReadyReplicas        int32 `json:"readyReplicas,omitempty"`
+	AvailableReplicas    int32 `json:"availableReplicas,omitempty"`
+	ObservedGeneration   int64 `json:"observedGeneration,omitempty"`
+}
+
+// ReplicaSet ensures that a specified number of pod replicas are running at any given time.
+type ReplicaSet struct {
+	unversioned.TypeMeta `json:",inline"`
+	v1.ObjectMeta        `json:"metadata,omitempty"`
+
+	Spec ReplicaSetSpec `json:"spec,omitempty"`
+
+	Status ReplicaSetStatus `json:"status,omitempty"`
+}
+
+// ReplicaSetList is a collection of ReplicaSets.
+type ReplicaSetList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []ReplicaSet `json:"items"`
+}
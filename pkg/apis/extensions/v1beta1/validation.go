@@ -0,0 +1,103 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/util/cron"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// ValidateRollingUpdateDaemonSet validates a RollingUpdateDaemonSet after defaulting has
+// already filled in MaxUnavailable and MaxSurge. Exactly one of the two must be non-zero,
+// since a surge-based rollout and an unavailability-based rollout are mutually exclusive
+// strategies for keeping a disruption budget during the update.
+func ValidateRollingUpdateDaemonSet(rollingUpdate *RollingUpdateDaemonSet) error {
+	unavailable := IntOrZero(rollingUpdate.MaxUnavailable)
+	surge := IntOrZero(rollingUpdate.MaxSurge)
+	if unavailable == 0 && surge == 0 {
+		return fmt.Errorf("may not be 0 for both maxUnavailable and maxSurge")
+	}
+	if unavailable != 0 && surge != 0 {
+		return fmt.Errorf("may not be set for both maxUnavailable and maxSurge")
+	}
+	return nil
+}
+
+// ValidateCronJobSchedule rejects a CronJob schedule that the cron parser can't make
+// sense of, so malformed schedules are caught at admission instead of silently never firing.
+func ValidateCronJobSchedule(schedule string) error {
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return fmt.Errorf("invalid schedule: %v", err)
+	}
+	return nil
+}
+
+// ValidateDeploymentStrategy rejects strategy-specific configuration that the
+// defaulter can't fix up on its own: a BlueGreen strategy with no ActiveService to cut
+// traffic over to, or a Canary strategy with no steps or non-monotonic weights.
+func ValidateDeploymentStrategy(strategy *DeploymentStrategy) error {
+	switch strategy.Type {
+	case BlueGreenDeploymentStrategyType:
+		if strategy.BlueGreen == nil || strategy.BlueGreen.ActiveService == "" {
+			return fmt.Errorf("blueGreen.activeService is required when strategy type is BlueGreen")
+		}
+	case CanaryDeploymentStrategyType:
+		if strategy.Canary == nil || len(strategy.Canary.Steps) == 0 {
+			return fmt.Errorf("canary.steps must have at least one step when strategy type is Canary")
+		}
+		previousWeight := int32(0)
+		for i, step := range strategy.Canary.Steps {
+			if (step.SetWeight == nil) == (step.Pause == nil) {
+				return fmt.Errorf("canary.steps[%d] must set exactly one of setWeight or pause", i)
+			}
+			if step.SetWeight == nil {
+				continue
+			}
+			if *step.SetWeight < previousWeight {
+				return fmt.Errorf("canary.steps[%d].setWeight (%d) must not be less than the previous step's weight (%d)", i, *step.SetWeight, previousWeight)
+			}
+			previousWeight = *step.SetWeight
+		}
+	}
+	return nil
+}
+
+// IntOrZero reports the magnitude of an IntOrString without needing a total to scale
+// a percentage against: a percentage string (e.g. "20%", valid per
+// RollingUpdateDaemonSet.MaxSurge/MaxUnavailable's doc comment) is read by its
+// percentage number rather than by intOrStr.IntValue(), which only understands plain
+// integers and would otherwise silently treat every percentage as 0. Used here and by
+// the daemon controller's rollout logic to decide whether a surge/unavailable field
+// is unset or an explicit zero, not to compute an actual scaled pod count.
+func IntOrZero(intOrStr *intstr.IntOrString) int {
+	if intOrStr == nil {
+		return 0
+	}
+	if intOrStr.Type == intstr.String {
+		percent := strings.TrimSuffix(intOrStr.StrVal, "%")
+		value, err := strconv.Atoi(percent)
+		if err != nil {
+			return 0
+		}
+		return value
+	}
+	return int(intOrStr.IntVal)
+}
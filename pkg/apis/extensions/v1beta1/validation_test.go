@@ -0,0 +1,160 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1_test
+
+import (
+	"testing"
+
+	. "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestValidateRollingUpdateDaemonSet(t *testing.T) {
+	zero := intstr.FromInt(0)
+	one := intstr.FromInt(1)
+	twentyPercent := intstr.FromString("20%")
+
+	tests := []struct {
+		name          string
+		rollingUpdate *RollingUpdateDaemonSet
+		expectError   bool
+	}{
+		{
+			name:          "MaxUnavailable set, MaxSurge zero",
+			rollingUpdate: &RollingUpdateDaemonSet{MaxUnavailable: &one, MaxSurge: &zero},
+			expectError:   false,
+		},
+		{
+			name:          "MaxSurge set, MaxUnavailable zero",
+			rollingUpdate: &RollingUpdateDaemonSet{MaxUnavailable: &zero, MaxSurge: &one},
+			expectError:   false,
+		},
+		{
+			name:          "both zero",
+			rollingUpdate: &RollingUpdateDaemonSet{MaxUnavailable: &zero, MaxSurge: &zero},
+			expectError:   true,
+		},
+		{
+			name:          "both non-zero",
+			rollingUpdate: &RollingUpdateDaemonSet{MaxUnavailable: &one, MaxSurge: &one},
+			expectError:   true,
+		},
+		{
+			name:          "percentage MaxSurge with zero MaxUnavailable is a valid surge-only config",
+			rollingUpdate: &RollingUpdateDaemonSet{MaxUnavailable: &zero, MaxSurge: &twentyPercent},
+			expectError:   false,
+		},
+	}
+
+	for _, test := range tests {
+		err := ValidateRollingUpdateDaemonSet(test.rollingUpdate)
+		if test.expectError && err == nil {
+			t.Errorf("%s: expected an error, got none", test.name)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
+
+func TestValidateCronJobSchedule(t *testing.T) {
+	tests := []struct {
+		schedule    string
+		expectError bool
+	}{
+		{"* * * * *", false},
+		{"*/5 * * * *", false},
+		{"@hourly", false},
+		{"@daily", false},
+		{"this is not a schedule", true},
+		{"* * * *", true},
+		{"60 * * * *", true},
+	}
+
+	for _, test := range tests {
+		err := ValidateCronJobSchedule(test.schedule)
+		if test.expectError && err == nil {
+			t.Errorf("%q: expected an error, got none", test.schedule)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("%q: unexpected error: %v", test.schedule, err)
+		}
+	}
+}
+
+func TestValidateDeploymentStrategy(t *testing.T) {
+	weight := func(w int32) CanaryStep { return CanaryStep{SetWeight: &w} }
+
+	tests := []struct {
+		name        string
+		strategy    *DeploymentStrategy
+		expectError bool
+	}{
+		{
+			name:     "BlueGreen with ActiveService is valid",
+			strategy: &DeploymentStrategy{Type: BlueGreenDeploymentStrategyType, BlueGreen: &BlueGreenDeployment{ActiveService: "app-active"}},
+		},
+		{
+			name:        "BlueGreen without ActiveService is rejected",
+			strategy:    &DeploymentStrategy{Type: BlueGreenDeploymentStrategyType, BlueGreen: &BlueGreenDeployment{}},
+			expectError: true,
+		},
+		{
+			name:        "BlueGreen with nil config is rejected",
+			strategy:    &DeploymentStrategy{Type: BlueGreenDeploymentStrategyType},
+			expectError: true,
+		},
+		{
+			name:     "Canary with monotonically non-decreasing weights is valid",
+			strategy: &DeploymentStrategy{Type: CanaryDeploymentStrategyType, Canary: &CanaryDeployment{Steps: []CanaryStep{weight(10), weight(10), weight(50), weight(100)}}},
+		},
+		{
+			name:        "Canary with decreasing weight is rejected",
+			strategy:    &DeploymentStrategy{Type: CanaryDeploymentStrategyType, Canary: &CanaryDeployment{Steps: []CanaryStep{weight(50), weight(10)}}},
+			expectError: true,
+		},
+		{
+			name:        "Canary with no steps is rejected",
+			strategy:    &DeploymentStrategy{Type: CanaryDeploymentStrategyType, Canary: &CanaryDeployment{}},
+			expectError: true,
+		},
+		{
+			name:     "Canary with a pause step is valid",
+			strategy: &DeploymentStrategy{Type: CanaryDeploymentStrategyType, Canary: &CanaryDeployment{Steps: []CanaryStep{weight(10), {Pause: &DurationOrIndefinite{Indefinite: true}}, weight(100)}}},
+		},
+		{
+			name:        "Canary step with neither setWeight nor pause is rejected",
+			strategy:    &DeploymentStrategy{Type: CanaryDeploymentStrategyType, Canary: &CanaryDeployment{Steps: []CanaryStep{{}}}},
+			expectError: true,
+		},
+		{
+			name:        "Canary step with both setWeight and pause is rejected",
+			strategy:    &DeploymentStrategy{Type: CanaryDeploymentStrategyType, Canary: &CanaryDeployment{Steps: []CanaryStep{{SetWeight: weight(10).SetWeight, Pause: &DurationOrIndefinite{Indefinite: true}}}}},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		err := ValidateDeploymentStrategy(test.strategy)
+		if test.expectError && err == nil {
+			t.Errorf("%s: expected an error, got none", test.name)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/util/cron"
+)
+
+// maxMissedSchedules bounds how many scheduled times getRecentUnmetScheduleTimes will
+// walk back through before giving up, so a long-dead controller waking up doesn't spend
+// its time replaying years of missed runs for a tight schedule.
+const maxMissedSchedules = 100
+
+// getRecentUnmetScheduleTimes returns the schedule times between the CronJob's
+// Status.LastScheduleTime (or its creation time if it has never run) and now that
+// haven't yet been acted on. Only the most recent one actually needs to be started;
+// the rest are reported so the caller can decide whether too many were missed.
+func getRecentUnmetScheduleTimes(sj v1beta1.CronJob, now time.Time) ([]time.Time, error) {
+	schedule, err := cron.ParseStandard(sj.Spec.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("unparseable schedule %q: %v", sj.Spec.Schedule, err)
+	}
+
+	var earliestTime time.Time
+	if sj.Status.LastScheduleTime != nil {
+		earliestTime = sj.Status.LastScheduleTime.Time
+	} else {
+		earliestTime = sj.CreationTimestamp.Time
+	}
+	if sj.Spec.StartingDeadlineSeconds != nil {
+		deadline := now.Add(-time.Duration(*sj.Spec.StartingDeadlineSeconds) * time.Second)
+		if deadline.After(earliestTime) {
+			earliestTime = deadline
+		}
+	}
+	if earliestTime.After(now) {
+		return nil, nil
+	}
+
+	var times []time.Time
+	for t := schedule.Next(earliestTime); !t.After(now); t = schedule.Next(t) {
+		times = append(times, t)
+		if len(times) > maxMissedSchedules {
+			return times, fmt.Errorf("too many missed start times (> %d); check the clock and the schedule for %s/%s", maxMissedSchedules, sj.Namespace, sj.Name)
+		}
+	}
+	return times, nil
+}
+
+// concurrencyDecision is what the controller should do about a CronJob's existing
+// active Jobs before starting a newly-scheduled run.
+type concurrencyDecision int
+
+const (
+	// startAllowed means the new Job can be created alongside any currently active ones.
+	startAllowed concurrencyDecision = iota
+	// startSkipped means the new Job should be skipped this time because one is already running.
+	startSkipped
+	// startAfterReplace means any currently active Jobs should be deleted, then the new one created.
+	startAfterReplace
+)
+
+// decideConcurrency maps a CronJob's ConcurrencyPolicy and its number of active Jobs to
+// the action the controller should take for a newly due scheduled time.
+func decideConcurrency(policy v1beta1.ConcurrencyPolicy, activeJobs int) concurrencyDecision {
+	if activeJobs == 0 {
+		return startAllowed
+	}
+	switch policy {
+	case v1beta1.ForbidConcurrent:
+		return startSkipped
+	case v1beta1.ReplaceConcurrent:
+		return startAfterReplace
+	default:
+		return startAllowed
+	}
+}
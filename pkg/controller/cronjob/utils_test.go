@@ -0,0 +1,102 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func TestGetRecentUnmetScheduleTimes(t *testing.T) {
+	now, err := time.Parse(time.RFC3339, "2016-05-19T10:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 30 minutes before now crosses exactly one hourly boundary (10:00), not two, so
+	// this is a true single-miss case. A 90 minute gap would cross both 09:00 and
+	// 10:00 and yield two unmet times.
+	last := unversioned.NewTime(now.Add(-30 * time.Minute))
+
+	sj := v1beta1.CronJob{
+		Spec: v1beta1.CronJobSpec{
+			Schedule: "0 * * * *",
+		},
+		Status: v1beta1.CronJobStatus{
+			LastScheduleTime: &last,
+		},
+	}
+
+	times, err := getRecentUnmetScheduleTimes(sj, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(times) != 1 {
+		t.Fatalf("expected exactly one missed schedule time, got %d: %v", len(times), times)
+	}
+	want := now.Truncate(time.Hour)
+	if !times[0].Equal(want) {
+		t.Errorf("got %s, want %s", times[0], want)
+	}
+}
+
+func TestGetRecentUnmetScheduleTimesNoneDue(t *testing.T) {
+	now, err := time.Parse(time.RFC3339, "2016-05-19T10:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := unversioned.NewTime(now)
+
+	sj := v1beta1.CronJob{
+		Spec: v1beta1.CronJobSpec{
+			Schedule: "0 * * * *",
+		},
+		Status: v1beta1.CronJobStatus{
+			LastScheduleTime: &last,
+		},
+	}
+
+	times, err := getRecentUnmetScheduleTimes(sj, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(times) != 0 {
+		t.Errorf("expected no missed schedule times, got %v", times)
+	}
+}
+
+func TestDecideConcurrency(t *testing.T) {
+	tests := []struct {
+		policy     v1beta1.ConcurrencyPolicy
+		activeJobs int
+		want       concurrencyDecision
+	}{
+		{v1beta1.AllowConcurrent, 0, startAllowed},
+		{v1beta1.AllowConcurrent, 2, startAllowed},
+		{v1beta1.ForbidConcurrent, 0, startAllowed},
+		{v1beta1.ForbidConcurrent, 1, startSkipped},
+		{v1beta1.ReplaceConcurrent, 0, startAllowed},
+		{v1beta1.ReplaceConcurrent, 1, startAfterReplace},
+	}
+	for _, test := range tests {
+		if got := decideConcurrency(test.policy, test.activeJobs); got != test.want {
+			t.Errorf("decideConcurrency(%v, %d) = %v, want %v", test.policy, test.activeJobs, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+// NodeRolloutAction describes what the rolling updater should do next for a single
+// node while rolling a DaemonSet out from oldPod to the updated template.
+type NodeRolloutAction int
+
+const (
+	// NodeRolloutNoop means the node is already up to date, or the rollout is
+	// waiting on in-flight work and should not be touched this sync.
+	NodeRolloutNoop NodeRolloutAction = iota
+	// NodeRolloutCreateSurge means a new pod should be created on the node while the
+	// old pod is left running, for use with a surge-based (MaxSurge > 0) rollout.
+	NodeRolloutCreateSurge
+	// NodeRolloutDeleteOld means the old pod should be deleted so a replacement can
+	// be scheduled, for use with an unavailability-based (MaxUnavailable > 0) rollout.
+	NodeRolloutDeleteOld
+	// NodeRolloutDeleteSurge means the surge pod has become available and the old
+	// pod it is replacing can now be torn down.
+	NodeRolloutDeleteSurge
+)
+
+// nodePodState is the state of a node as seen by the rolling updater: whether it
+// currently has an out of date pod, and whether a surge pod has already been
+// created for it and, if so, whether that pod is available.
+type nodePodState struct {
+	hasOldPod         bool
+	hasSurgePod       bool
+	surgePodAvailable bool
+}
+
+// nextNodeRolloutAction decides what to do for a single node given the DaemonSet's
+// RollingUpdate configuration. Surge mode (MaxSurge > 0) creates the replacement pod
+// before removing the old one, so the node is never without a running pod; the
+// classic mode (MaxUnavailable > 0) instead deletes the old pod up front and waits
+// for the daemon set controller's normal node-coverage sync to create the new one.
+//
+// surgeBudget is how many more nodes are allowed to be surging right now across the
+// whole DaemonSet: MaxSurge (scaled against the current desired node count) minus
+// the number of nodes already carrying a surge pod. The caller — the rolling update
+// sync loop, which has the full node list and so can count in-flight surges — must
+// compute and decrement this across the nodes it walks in a single sync, otherwise
+// every node would be offered a surge slot simultaneously regardless of MaxSurge.
+func nextNodeRolloutAction(rollingUpdate *v1beta1.RollingUpdateDaemonSet, state nodePodState, surgeBudget int) NodeRolloutAction {
+	surging := v1beta1.IntOrZero(rollingUpdate.MaxSurge) > 0
+
+	if !state.hasOldPod {
+		return NodeRolloutNoop
+	}
+
+	if !surging {
+		return NodeRolloutDeleteOld
+	}
+
+	if !state.hasSurgePod {
+		if surgeBudget <= 0 {
+			return NodeRolloutNoop
+		}
+		return NodeRolloutCreateSurge
+	}
+	if state.surgePodAvailable {
+		return NodeRolloutDeleteSurge
+	}
+	return NodeRolloutNoop
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestNextNodeRolloutAction(t *testing.T) {
+	zero := intstr.FromInt(0)
+	one := intstr.FromInt(1)
+	unavailableRollout := &v1beta1.RollingUpdateDaemonSet{MaxUnavailable: &one, MaxSurge: &zero}
+	surgeRollout := &v1beta1.RollingUpdateDaemonSet{MaxUnavailable: &zero, MaxSurge: &one}
+
+	tests := []struct {
+		name          string
+		rollingUpdate *v1beta1.RollingUpdateDaemonSet
+		state         nodePodState
+		surgeBudget   int
+		want          NodeRolloutAction
+	}{
+		{"up to date node is left alone", unavailableRollout, nodePodState{}, 1, NodeRolloutNoop},
+		{"classic rollout deletes the old pod outright", unavailableRollout, nodePodState{hasOldPod: true}, 0, NodeRolloutDeleteOld},
+		{"surge rollout creates the replacement first", surgeRollout, nodePodState{hasOldPod: true}, 1, NodeRolloutCreateSurge},
+		{"surge rollout withholds the replacement once the surge budget is exhausted", surgeRollout, nodePodState{hasOldPod: true}, 0, NodeRolloutNoop},
+		{"surge rollout waits for the new pod to become available", surgeRollout, nodePodState{hasOldPod: true, hasSurgePod: true}, 1, NodeRolloutNoop},
+		{"surge rollout deletes the old pod once the surge pod is ready", surgeRollout, nodePodState{hasOldPod: true, hasSurgePod: true, surgePodAvailable: true}, 1, NodeRolloutDeleteSurge},
+	}
+
+	for _, test := range tests {
+		if got := nextNodeRolloutAction(test.rollingUpdate, test.state, test.surgeBudget); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"time"
+
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+// shouldPromoteBlueGreen decides whether the new ("green") ReplicaSet should be
+// promoted to active now. It is promoted once it is fully available, auto-promotion
+// is enabled, and any configured AutoPromotionSeconds bake time has elapsed. When
+// auto-promotion is disabled (or unset) this always returns false.
+func shouldPromoteBlueGreen(blueGreen *v1beta1.BlueGreenDeployment, newRSFullyAvailable bool, newRSAvailableSince time.Time, now time.Time) bool {
+	if !newRSFullyAvailable {
+		return false
+	}
+	if blueGreen.AutoPromotionEnabled == nil || !*blueGreen.AutoPromotionEnabled {
+		return false
+	}
+	if blueGreen.AutoPromotionSeconds == nil {
+		return true
+	}
+	bakeTime := time.Duration(*blueGreen.AutoPromotionSeconds) * time.Second
+	return now.Sub(newRSAvailableSince) >= bakeTime
+}
+
+// canaryStepAt returns the weight step at index i of a Canary rollout and whether the
+// rollout should pause there, so the caller can decide whether to advance pod counts or
+// wait for Status.PauseConditions[i].Resume.
+func canaryStepAt(canary *v1beta1.CanaryDeployment, i int) (weight int32, pause bool) {
+	if i < 0 || i >= len(canary.Steps) {
+		return 100, false
+	}
+	step := canary.Steps[i]
+	if step.SetWeight != nil {
+		return *step.SetWeight, false
+	}
+	return -1, true
+}
+
+// canaryReplicaSplit divides desiredReplicas between the new and old ReplicaSets
+// according to the given traffic weight percentage (0-100) for the new one, rounding
+// the new ReplicaSet's share up so it never falls to zero for a nonzero weight.
+func canaryReplicaSplit(desiredReplicas int32, weightPercent int32) (newReplicas, oldReplicas int32) {
+	if weightPercent <= 0 {
+		return 0, desiredReplicas
+	}
+	if weightPercent >= 100 {
+		return desiredReplicas, 0
+	}
+	newReplicas = (desiredReplicas*weightPercent + 99) / 100
+	return newReplicas, desiredReplicas - newReplicas
+}
+
+// canaryPauseResumed reports whether a rollout paused at step i should resume: either
+// the pause had a fixed duration that has elapsed, or an external caller resumed it.
+func canaryPauseResumed(pause *v1beta1.DurationOrIndefinite, pauseStartTime time.Time, now time.Time, condition *v1beta1.DeploymentPauseCondition) bool {
+	if condition != nil && condition.Resume {
+		return true
+	}
+	if pause.Indefinite || pause.Duration == nil {
+		return false
+	}
+	return now.Sub(pauseStartTime) >= pause.Duration.Duration
+}
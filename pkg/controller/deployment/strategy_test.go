@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func boolPtr(b bool) *bool    { return &b }
+func int32Ptr(i int32) *int32 { return &i }
+
+func TestShouldPromoteBlueGreen(t *testing.T) {
+	now := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	available := now.Add(-2 * time.Minute)
+
+	tests := []struct {
+		name      string
+		blueGreen *v1beta1.BlueGreenDeployment
+		available bool
+		want      bool
+	}{
+		{"not yet available", &v1beta1.BlueGreenDeployment{AutoPromotionEnabled: boolPtr(true)}, false, false},
+		{"available, auto-promotion disabled", &v1beta1.BlueGreenDeployment{AutoPromotionEnabled: boolPtr(false)}, true, false},
+		{"available, no bake time configured", &v1beta1.BlueGreenDeployment{AutoPromotionEnabled: boolPtr(true)}, true, true},
+		{"available, bake time not yet elapsed", &v1beta1.BlueGreenDeployment{AutoPromotionEnabled: boolPtr(true), AutoPromotionSeconds: int32Ptr(300)}, true, false},
+		{"available, bake time elapsed", &v1beta1.BlueGreenDeployment{AutoPromotionEnabled: boolPtr(true), AutoPromotionSeconds: int32Ptr(60)}, true, true},
+	}
+	for _, test := range tests {
+		if got := shouldPromoteBlueGreen(test.blueGreen, test.available, available, now); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestCanaryReplicaSplit(t *testing.T) {
+	tests := []struct {
+		desired, weight  int32
+		newReplicas, old int32
+	}{
+		{10, 0, 0, 10},
+		{10, 100, 10, 0},
+		{10, 50, 5, 5},
+		{10, 25, 3, 7}, // rounds the new replica count up
+		{1, 1, 1, 0},
+	}
+	for _, test := range tests {
+		newReplicas, old := canaryReplicaSplit(test.desired, test.weight)
+		if newReplicas != test.newReplicas || old != test.old {
+			t.Errorf("canaryReplicaSplit(%d, %d) = (%d, %d), want (%d, %d)", test.desired, test.weight, newReplicas, old, test.newReplicas, test.old)
+		}
+	}
+}
+
+func TestCanaryPauseResumed(t *testing.T) {
+	now := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	started := now.Add(-90 * time.Second)
+
+	tests := []struct {
+		name      string
+		pause     *v1beta1.DurationOrIndefinite
+		condition *v1beta1.DeploymentPauseCondition
+		want      bool
+	}{
+		{"indefinite, not resumed", &v1beta1.DurationOrIndefinite{Indefinite: true}, nil, false},
+		{"indefinite, resumed externally", &v1beta1.DurationOrIndefinite{Indefinite: true}, &v1beta1.DeploymentPauseCondition{Resume: true}, true},
+		{"timed, not yet elapsed", &v1beta1.DurationOrIndefinite{Duration: &unversioned.Duration{Duration: 5 * time.Minute}}, nil, false},
+		{"timed, elapsed", &v1beta1.DurationOrIndefinite{Duration: &unversioned.Duration{Duration: 30 * time.Second}}, nil, true},
+	}
+	for _, test := range tests {
+		if got := canaryPauseResumed(test.pause, started, now, test.condition); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
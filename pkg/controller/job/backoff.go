@@ -0,0 +1,52 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import "time"
+
+// Failure reasons recorded on a Job's Failed condition.
+const (
+	// ReasonBackoffLimitExceeded is used when a Job's pods have failed more times
+	// than Spec.BackoffLimit allows.
+	ReasonBackoffLimitExceeded = "BackoffLimitExceeded"
+	// ReasonDeadlineExceeded is used when a Job has been active for at least
+	// Spec.ActiveDeadlineSeconds.
+	ReasonDeadlineExceeded = "DeadlineExceeded"
+)
+
+// pastBackoffLimit reports whether a Job with the given number of failed pod
+// executions has exceeded its configured BackoffLimit and should be marked Failed.
+// A nil backoffLimit means no limit was defaulted yet, so no Job is ever failed
+// by count alone.
+func pastBackoffLimit(failedCount int, backoffLimit *int32) bool {
+	if backoffLimit == nil {
+		return false
+	}
+	return int32(failedCount) > *backoffLimit
+}
+
+// pastActiveDeadline reports whether a Job that started at startTime and is still
+// being evaluated at now has run for at least its ActiveDeadlineSeconds, and should
+// have its active pods terminated and be marked Failed.
+func pastActiveDeadline(startTime time.Time, now time.Time, activeDeadlineSeconds *int64) bool {
+	if activeDeadlineSeconds == nil {
+		return false
+	}
+	duration := now.Sub(startTime)
+	allowed := time.Duration(*activeDeadlineSeconds) * time.Second
+	return duration >= allowed
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func newInt32(val int32) *int32 {
+	return &val
+}
+
+func newInt64(val int64) *int64 {
+	return &val
+}
+
+func TestPastBackoffLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		failedCount  int
+		backoffLimit *int32
+		want         bool
+	}{
+		{"nil limit never fails", 100, nil, false},
+		{"under the limit", 2, newInt32(6), false},
+		{"at the limit", 6, newInt32(6), false},
+		{"over the limit", 7, newInt32(6), true},
+		{"zero limit fails after first failure", 1, newInt32(0), true},
+	}
+	for _, test := range tests {
+		if got := pastBackoffLimit(test.failedCount, test.backoffLimit); got != test.want {
+			t.Errorf("%s: pastBackoffLimit(%d, %v) = %v, want %v", test.name, test.failedCount, test.backoffLimit, got, test.want)
+		}
+	}
+}
+
+func TestPastActiveDeadline(t *testing.T) {
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                  string
+		now                   time.Time
+		activeDeadlineSeconds *int64
+		want                  bool
+	}{
+		{"nil deadline never fails", start.Add(time.Hour), nil, false},
+		{"before the deadline", start.Add(30 * time.Second), newInt64(60), false},
+		{"at the deadline", start.Add(60 * time.Second), newInt64(60), true},
+		{"past the deadline", start.Add(90 * time.Second), newInt64(60), true},
+	}
+	for _, test := range tests {
+		if got := pastActiveDeadline(start, test.now, test.activeDeadlineSeconds); got != test.want {
+			t.Errorf("%s: pastActiveDeadline(...) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cron implements parsing of the standard 5-field cron expression
+// format used by CronJob.Spec.Schedule, without pulling in an external
+// scheduling library.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule describes a job's duty cycle.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record whether the day-of-month and day-of-week fields
+	// were each given as "*", since cron ANDs the two when either one is "*" and
+	// ORs them only when both are explicitly restricted.
+	domStar, dowStar bool
+}
+
+// macros are predefined schedules which replace the standard 5-field syntax.
+var macros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+var fieldBounds = []struct {
+	min, max uint
+}{
+	{0, 59}, // minute
+	{0, 23}, // hour
+	{1, 31}, // day of month
+	{1, 12}, // month
+	{0, 6},  // day of week
+}
+
+// ParseStandard parses a standard 5-field cron expression ("minute hour dom month dow"),
+// or one of the @hourly/@daily/@weekly/@monthly/@yearly(@annually) macros, and returns a
+// Schedule that can be used to compute the next activation time. It rejects anything that
+// doesn't resolve to a valid 5-field expression.
+func ParseStandard(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if replacement, ok := macros[spec]; ok {
+		spec = replacement
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected exactly 5 fields, found %d: %q", len(fields), spec)
+	}
+
+	var bits [5]uint64
+	for i, field := range fields {
+		parsed, err := parseField(field, fieldBounds[i].min, fieldBounds[i].max)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: %v", spec, err)
+		}
+		bits[i] = parsed
+	}
+
+	return Schedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+// parseField parses a single cron field (e.g. "*", "*/5", "1,3,5", "1-5") into a bitmask
+// with bit N set when value N is included.
+func parseField(field string, min, max uint) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		lo, hi, step, err := parseRange(part, min, max)
+		if err != nil {
+			return 0, err
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	if bits == 0 {
+		return 0, fmt.Errorf("field %q does not select any values", field)
+	}
+	return bits, nil
+}
+
+func parseRange(expr string, min, max uint) (lo, hi, step uint, err error) {
+	step = 1
+	rangeAndStep := strings.SplitN(expr, "/", 2)
+	if len(rangeAndStep) == 2 {
+		s, err := strconv.Atoi(rangeAndStep[1])
+		if err != nil || s <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid step in %q", expr)
+		}
+		step = uint(s)
+	}
+
+	lowAndHigh := rangeAndStep[0]
+	switch {
+	case lowAndHigh == "*":
+		lo, hi = min, max
+	case strings.Contains(lowAndHigh, "-"):
+		parts := strings.SplitN(lowAndHigh, "-", 2)
+		loVal, err1 := strconv.Atoi(parts[0])
+		hiVal, err2 := strconv.Atoi(parts[1])
+		if err1 != nil || err2 != nil {
+			return 0, 0, 0, fmt.Errorf("invalid range %q", lowAndHigh)
+		}
+		lo, hi = uint(loVal), uint(hiVal)
+	default:
+		val, err := strconv.Atoi(lowAndHigh)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid value %q", lowAndHigh)
+		}
+		lo, hi = uint(val), uint(val)
+	}
+
+	if lo < min || hi > max || lo > hi {
+		return 0, 0, 0, fmt.Errorf("value %q out of range [%d, %d]", lowAndHigh, min, max)
+	}
+	return lo, hi, step, nil
+}
+
+// Next returns the closest time instant, greater than the passed instant, that matches
+// the schedule, truncated to the minute.
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.Add(time.Minute - time.Duration(t.Second())*time.Second).Truncate(time.Minute)
+
+	// Five years should be plenty of lookahead to find a match for any valid schedule;
+	// bail out rather than loop forever on a schedule that can never match (e.g. Feb 30th).
+	yearLimit := t.Year() + 5
+
+WRAP:
+	for t.Year() <= yearLimit {
+		for s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			if t.Month() == time.January {
+				continue WRAP
+			}
+		}
+
+		for !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			if t.Day() == 1 {
+				continue WRAP
+			}
+		}
+
+		for s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+			if t.Hour() == 0 {
+				continue WRAP
+			}
+		}
+
+		for s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			if t.Minute() == 0 {
+				continue WRAP
+			}
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches reports whether t's day-of-month and day-of-week satisfy the schedule.
+// Following standard cron semantics, if both fields are restricted the day matches
+// when either one does; if only one is restricted, that one alone decides.
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
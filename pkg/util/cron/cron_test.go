@@ -0,0 +1,86 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStandard(t *testing.T) {
+	tests := []string{
+		"* * * * *",
+		"*/5 * * * *",
+		"0 0 1,15 * *",
+		"0 9-17 * * 1-5",
+		"@hourly",
+		"@daily",
+		"@weekly",
+		"@monthly",
+		"@yearly",
+	}
+	for _, spec := range tests {
+		if _, err := ParseStandard(spec); err != nil {
+			t.Errorf("ParseStandard(%q) returned unexpected error: %v", spec, err)
+		}
+	}
+}
+
+func TestParseStandardRejectsMalformed(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* * * * 8",
+		"not a schedule",
+	}
+	for _, spec := range tests {
+		if _, err := ParseStandard(spec); err == nil {
+			t.Errorf("ParseStandard(%q) expected an error, got none", spec)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		spec     string
+		time     string
+		expected string
+	}{
+		{"0 * * * *", "2016-01-01T10:15:00Z", "2016-01-01T11:00:00Z"},
+		{"*/15 * * * *", "2016-01-01T10:01:00Z", "2016-01-01T10:15:00Z"},
+		{"@daily", "2016-01-01T10:15:00Z", "2016-01-02T00:00:00Z"},
+		{"0 0 1 * *", "2016-01-15T00:00:00Z", "2016-02-01T00:00:00Z"},
+	}
+	for _, test := range tests {
+		schedule, err := ParseStandard(test.spec)
+		if err != nil {
+			t.Fatalf("ParseStandard(%q) returned error: %v", test.spec, err)
+		}
+		from, err := time.Parse(time.RFC3339, test.time)
+		if err != nil {
+			t.Fatalf("invalid test time %q: %v", test.time, err)
+		}
+		want, err := time.Parse(time.RFC3339, test.expected)
+		if err != nil {
+			t.Fatalf("invalid expected time %q: %v", test.expected, err)
+		}
+		if got := schedule.Next(from); !got.Equal(want) {
+			t.Errorf("%q.Next(%s) = %s, want %s", test.spec, test.time, got, want)
+		}
+	}
+}